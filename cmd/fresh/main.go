@@ -0,0 +1,122 @@
+// Command fresh is a minimal greeter, usable as a one-shot CLI, an
+// interactive prompt, or a tiny HTTP service.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/xscriptor/fresh/greeting"
+	"github.com/xscriptor/fresh/prompt"
+)
+
+// greetHandler serves a greeting, built with g, for the name given in the
+// "name" query parameter, defaulting to "World" when it is absent.
+func greetHandler(g *greeting.Greeter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			name = "World"
+		}
+		if err := g.Greet(w, name); err != nil {
+			log.Printf("greet: writing response: %v", err)
+		}
+	}
+}
+
+// namesDir returns the local store of previously greeted names, creating it
+// if necessary.
+func namesDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, ".fresh", "names")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// runPrompt reads a name from stdin, greets it with g, and saves it to the
+// local names store, asking for confirmation before overwriting an
+// existing entry.
+func runPrompt(g *greeting.Greeter) error {
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Print("Name: ")
+	if !scanner.Scan() {
+		return scanner.Err()
+	}
+	name := scanner.Text()
+
+	if err := g.Greet(os.Stdout, name); err != nil {
+		return err
+	}
+
+	// name comes straight from stdin, so reject anything that could escape
+	// the names store as a path (e.g. "../../.bashrc" or "..").
+	if !prompt.ValidName(name) {
+		return fmt.Errorf("invalid name %q", name)
+	}
+
+	dir, err := namesDir()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); err == nil {
+		msg := fmt.Sprintf("Overwrite existing entry for %s? (y/N): ", name)
+		if !prompt.Confirm(msg, os.Stdin, os.Stdout) {
+			return nil
+		}
+	}
+	return os.WriteFile(path, []byte(name), 0o644)
+}
+
+func main() {
+	serveAddr := flag.String("serve", "", "address to serve HTTP greetings on, e.g. :8080")
+	lang := flag.String("lang", os.Getenv("LANG"), "locale to greet in (en, fr, es, de, nl)")
+	templatePath := flag.String("template", "", "path to a custom greeting template")
+	flag.Parse()
+
+	var (
+		g   *greeting.Greeter
+		err error
+	)
+	if *templatePath != "" {
+		g, err = greeting.NewFromFile(*templatePath)
+	} else {
+		g, err = greeting.New(*lang)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if flag.Arg(0) == "prompt" {
+		if err := runPrompt(g); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *serveAddr != "" {
+		http.HandleFunc("/", greetHandler(g))
+		if err := http.ListenAndServe(*serveAddr, nil); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := g.Greet(os.Stdout, "World"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
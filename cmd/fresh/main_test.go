@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xscriptor/fresh/greeting"
+)
+
+func TestGreetHandlerDefaultsToWorld(t *testing.T) {
+	g, err := greeting.New("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	rec := httptest.NewRecorder()
+	greetHandler(g)(rec, req)
+
+	if got, want := rec.Body.String(), "Hello, World!\n"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestGreetHandlerUsesNameQueryParam(t *testing.T) {
+	g, err := greeting.New("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest("GET", "/?name=Alice", nil)
+	rec := httptest.NewRecorder()
+	greetHandler(g)(rec, req)
+
+	if got, want := rec.Body.String(), "Hello, Alice!\n"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+// Package greeting renders locale-specific greetings from text/template.
+package greeting
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// builtinTemplates maps a bare locale code to its greeting template.
+var builtinTemplates = map[string]string{
+	"en": "Hello, {{.}}!",
+	"fr": "Bonjour, {{.}}!",
+	"es": "Hola, {{.}}!",
+	"de": "Hallo, {{.}}!",
+	"nl": "Hallo, {{.}}!",
+}
+
+// defaultLocale is used when the requested locale has no built-in template.
+const defaultLocale = "en"
+
+// Greeter renders a greeting for a name using a locale-specific template.
+type Greeter struct {
+	tmpl *template.Template
+}
+
+// NormalizeLocale reduces a LANG-style value such as "fr_FR.UTF-8" down to
+// its bare, lowercase language code ("fr") for lookup in builtinTemplates.
+func NormalizeLocale(locale string) string {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "_."); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
+
+// New builds a Greeter for the given locale (e.g. "en", "fr_FR.UTF-8"),
+// falling back to defaultLocale if it has no built-in template.
+func New(locale string) (*Greeter, error) {
+	text, ok := builtinTemplates[NormalizeLocale(locale)]
+	if !ok {
+		text = builtinTemplates[defaultLocale]
+	}
+	tmpl, err := template.New("greeting").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Greeter{tmpl: tmpl}, nil
+}
+
+// NewFromFile builds a Greeter from a custom template file.
+func NewFromFile(path string) (*Greeter, error) {
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Greeter{tmpl: tmpl}, nil
+}
+
+// Greet renders the greeting for name and writes it to w, followed by a
+// newline.
+func (g *Greeter) Greet(w io.Writer, name string) error {
+	if err := g.tmpl.Execute(w, name); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
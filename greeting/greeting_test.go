@@ -0,0 +1,75 @@
+package greeting
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	cases := map[string]string{
+		"en":          "en",
+		"FR":          "fr",
+		"es_ES.UTF-8": "es",
+		"de_DE":       "de",
+		"nl.UTF-8":    "nl",
+		"":            "",
+	}
+	for in, want := range cases {
+		if got := NormalizeLocale(in); got != want {
+			t.Errorf("NormalizeLocale(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNewHonorsFullLangValue(t *testing.T) {
+	g, err := New("fr_FR.UTF-8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := g.Greet(&buf, "World"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "Bonjour, World!\n"; got != want {
+		t.Errorf("Greet() = %q, want %q", got, want)
+	}
+}
+
+func TestGreetAllBuiltinLocales(t *testing.T) {
+	cases := map[string]string{
+		"en": "Hello, Alice!\n",
+		"fr": "Bonjour, Alice!\n",
+		"es": "Hola, Alice!\n",
+		"de": "Hallo, Alice!\n",
+		"nl": "Hallo, Alice!\n",
+	}
+	for locale, want := range cases {
+		t.Run(locale, func(t *testing.T) {
+			g, err := New(locale)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if err := g.Greet(&buf, "Alice"); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != want {
+				t.Errorf("Greet() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNewFallsBackToDefaultLocale(t *testing.T) {
+	g, err := New("xx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := g.Greet(&buf, "World"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "Hello, World!\n"; got != want {
+		t.Errorf("Greet() = %q, want %q", got, want)
+	}
+}
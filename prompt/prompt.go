@@ -0,0 +1,38 @@
+// Package prompt holds small interactive-CLI helpers that are easiest to
+// unit-test when decoupled from the process's actual stdin/stdout.
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Confirm prints msg to out, reads a line from in, and reports whether the
+// answer was an affirmative "y" or "yes" (case-insensitive). Any other
+// response, including an empty one, is treated as "no".
+func Confirm(msg string, in io.Reader, out io.Writer) bool {
+	fmt.Fprint(out, msg)
+	scanner := bufio.NewScanner(in)
+	if !scanner.Scan() {
+		return false
+	}
+	switch strings.ToLower(scanner.Text()) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidName reports whether name is safe to use as a single path component,
+// e.g. when persisting it under a names store. It rejects the empty string,
+// ".", "..", and any name containing a path separator.
+func ValidName(name string) bool {
+	if name == "" || name == "." || name == ".." || name == string(filepath.Separator) {
+		return false
+	}
+	return name == filepath.Base(name)
+}
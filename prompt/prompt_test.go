@@ -0,0 +1,54 @@
+package prompt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConfirm(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"lowercase y", "y\n", true},
+		{"word yes", "yes\n", true},
+		{"mixed case Yes", "Yes\n", true},
+		{"no", "n\n", false},
+		{"empty line", "\n", false},
+		{"no input", "", false},
+		{"unrecognized word", "sure\n", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			got := Confirm("Overwrite? (y/N): ", strings.NewReader(tc.input), &out)
+			if got != tc.want {
+				t.Errorf("Confirm(%q) = %v, want %v", tc.input, got, tc.want)
+			}
+			if !strings.Contains(out.String(), "Overwrite? (y/N): ") {
+				t.Errorf("Confirm did not write the prompt message, got %q", out.String())
+			}
+		})
+	}
+}
+
+func TestValidName(t *testing.T) {
+	cases := map[string]bool{
+		"alice":       true,
+		"Alice-Smith": true,
+		"":            false,
+		".":           false,
+		"..":          false,
+		"../x":        false,
+		"/etc/passwd": false,
+		"a/../../etc": false,
+	}
+	for name, want := range cases {
+		if got := ValidName(name); got != want {
+			t.Errorf("ValidName(%q) = %v, want %v", name, got, want)
+		}
+	}
+}